@@ -0,0 +1,28 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// Cache is a key/value store with TTL support, backed by Redis in
+// production and by NoopCache in tests.
+type Cache interface {
+	// Get returns the value stored under key, or ok=false if it is
+	// absent or expired.
+	Get(ctx context.Context, key string) (value string, ok bool, err error)
+
+	// Set stores value under key, replacing any existing value.
+	Set(ctx context.Context, key, value string, ttl time.Duration) error
+
+	// SetNX stores value under key only if key is not already set. It
+	// reports whether the value was written.
+	SetNX(ctx context.Context, key, value string, ttl time.Duration) (bool, error)
+
+	// Delete removes key, if present.
+	Delete(ctx context.Context, key string) error
+
+	// WithTTL returns a Cache that applies ttl to every Set/SetNX call
+	// made through it, overriding whatever ttl the caller passes.
+	WithTTL(ttl time.Duration) Cache
+}
@@ -0,0 +1,30 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// GetJSON fetches key from c and unmarshals it into a T. ok is false if
+// key is absent; err is non-nil only on a cache or unmarshal failure.
+func GetJSON[T any](ctx context.Context, c Cache, key string) (value T, ok bool, err error) {
+	raw, ok, err := c.Get(ctx, key)
+	if err != nil || !ok {
+		return value, ok, err
+	}
+	if err := json.Unmarshal([]byte(raw), &value); err != nil {
+		return value, false, fmt.Errorf("cache: unmarshal %q: %w", key, err)
+	}
+	return value, true, nil
+}
+
+// SetJSON marshals value and stores it under key with the given ttl.
+func SetJSON[T any](ctx context.Context, c Cache, key string, value T, ttl time.Duration) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("cache: marshal %q: %w", key, err)
+	}
+	return c.Set(ctx, key, string(raw), ttl)
+}
@@ -0,0 +1,31 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// NoopCache is a Cache that never stores anything. It's useful in tests
+// and in any environment that wants cache-through behavior to degrade to
+// "always miss" rather than fail.
+type NoopCache struct{}
+
+func (NoopCache) Get(ctx context.Context, key string) (string, bool, error) {
+	return "", false, nil
+}
+
+func (NoopCache) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+	return nil
+}
+
+func (NoopCache) SetNX(ctx context.Context, key, value string, ttl time.Duration) (bool, error) {
+	return true, nil
+}
+
+func (NoopCache) Delete(ctx context.Context, key string) error {
+	return nil
+}
+
+func (NoopCache) WithTTL(ttl time.Duration) Cache {
+	return NoopCache{}
+}
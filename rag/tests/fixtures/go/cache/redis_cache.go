@@ -0,0 +1,54 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisCache is a Cache backed by a *redis.Client.
+type RedisCache struct {
+	client *redis.Client
+	ttl    time.Duration // overrides the ttl argument when set via WithTTL
+}
+
+// NewRedisCache wraps client as a Cache.
+func NewRedisCache(client *redis.Client) *RedisCache {
+	return &RedisCache{client: client}
+}
+
+func (c *RedisCache) ttlFor(ttl time.Duration) time.Duration {
+	if c.ttl > 0 {
+		return c.ttl
+	}
+	return ttl
+}
+
+func (c *RedisCache) Get(ctx context.Context, key string) (string, bool, error) {
+	value, err := c.client.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return value, true, nil
+}
+
+func (c *RedisCache) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+	return c.client.Set(ctx, key, value, c.ttlFor(ttl)).Err()
+}
+
+func (c *RedisCache) SetNX(ctx context.Context, key, value string, ttl time.Duration) (bool, error) {
+	return c.client.SetNX(ctx, key, value, c.ttlFor(ttl)).Result()
+}
+
+func (c *RedisCache) Delete(ctx context.Context, key string) error {
+	return c.client.Del(ctx, key).Err()
+}
+
+func (c *RedisCache) WithTTL(ttl time.Duration) Cache {
+	return &RedisCache{client: c.client, ttl: ttl}
+}
@@ -0,0 +1,114 @@
+package client
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Client is an HTTP client with configurable read/write deadlines,
+// modeled on the cancel-channel + timer pattern net.Conn implementations
+// use for their own deadlines.
+type Client struct {
+	httpClient *http.Client
+
+	// MaxRetries is how many additional attempts are made after a 5xx
+	// response, with exponential backoff between attempts. Zero (the
+	// default) disables retries: a failing request is surfaced to the
+	// caller immediately rather than silently retried against a
+	// possibly already-overloaded downstream.
+	MaxRetries int
+	RetryBase  time.Duration
+
+	mu            sync.Mutex
+	readDeadline  time.Time
+	writeDeadline time.Time
+	readCancelCh  chan struct{}
+	writeCancelCh chan struct{}
+	readTimer     *time.Timer
+	writeTimer    *time.Timer
+}
+
+// New returns a Client with no deadlines set and retries disabled.
+func New() *Client {
+	return &Client{
+		httpClient:    http.DefaultClient,
+		RetryBase:     100 * time.Millisecond,
+		readCancelCh:  make(chan struct{}),
+		writeCancelCh: make(chan struct{}),
+	}
+}
+
+// SetReadDeadline arms the deadline that bounds FetchUserProfile calls.
+func (c *Client) SetReadDeadline(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.readDeadline = t
+	setDeadline(&c.readCancelCh, &c.readTimer, t)
+}
+
+// SetWriteDeadline arms the deadline that bounds NotifyService calls.
+func (c *Client) SetWriteDeadline(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.writeDeadline = t
+	setDeadline(&c.writeCancelCh, &c.writeTimer, t)
+}
+
+// SetDeadline arms both the read and write deadlines to t.
+func (c *Client) SetDeadline(t time.Time) {
+	c.SetReadDeadline(t)
+	c.SetWriteDeadline(t)
+}
+
+// readCancel returns the channel that closes when the read deadline
+// elapses.
+func (c *Client) readCancel() chan struct{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.readCancelCh
+}
+
+// writeCancel returns the channel that closes when the write deadline
+// elapses.
+func (c *Client) writeCancel() chan struct{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.writeCancelCh
+}
+
+// setDeadline arms *ch/*timer to close *ch when t elapses, following the
+// same shape as net.Conn's internal deadlineTimer: stop any existing
+// timer, make sure *ch reflects "not yet fired", then either leave it
+// disarmed (t is zero), fire it immediately (t is already past), or
+// schedule it for later. The channel captured by the AfterFunc closure is
+// read at schedule time so a later SetReadDeadline/SetWriteDeadline call
+// that replaces *ch doesn't affect waiters already holding the old one.
+func setDeadline(ch *chan struct{}, timer **time.Timer, t time.Time) {
+	if *timer != nil && !(*timer).Stop() {
+		// The timer already fired (or is firing); *ch may already be
+		// closed from that fire, so replace it before rearming.
+		*ch = make(chan struct{})
+	}
+
+	select {
+	case <-*ch:
+		*ch = make(chan struct{})
+	default:
+	}
+
+	if t.IsZero() {
+		return
+	}
+
+	dur := time.Until(t)
+	if dur <= 0 {
+		close(*ch)
+		return
+	}
+
+	cur := *ch
+	*timer = time.AfterFunc(dur, func() {
+		close(cur)
+	})
+}
@@ -0,0 +1,15 @@
+package client
+
+import "errors"
+
+// ErrCanceled is returned when a request's context is canceled before the
+// HTTP call completes.
+var ErrCanceled = errors.New("client: request canceled")
+
+// ErrTimeout is returned when a request's read or write deadline elapses
+// before the HTTP call completes.
+var ErrTimeout = errors.New("client: deadline exceeded")
+
+// ErrRetriesExhausted is returned when every attempt, including retries,
+// ended in a 5xx response.
+var ErrRetriesExhausted = errors.New("client: retries exhausted")
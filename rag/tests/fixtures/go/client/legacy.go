@@ -0,0 +1,28 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// defaultClient backs the package-level FetchUserProfile/NotifyService
+// helpers below. It has no deadlines or retries configured.
+var defaultClient = New()
+
+// FetchUserProfile fetches the profile for userID from baseURL using the
+// package-level default Client.
+//
+// Deprecated: construct a *Client with New and call its FetchUserProfile
+// method instead, so callers can configure deadlines and retries.
+func FetchUserProfile(baseURL string, userID string) (*http.Response, error) {
+	return defaultClient.FetchUserProfile(context.Background(), baseURL, userID)
+}
+
+// NotifyService posts a notification to endpoint using the package-level
+// default Client.
+//
+// Deprecated: construct a *Client with New and call its NotifyService
+// method instead, so callers can configure deadlines and retries.
+func NotifyService(endpoint string) error {
+	return defaultClient.NotifyService(context.Background(), endpoint)
+}
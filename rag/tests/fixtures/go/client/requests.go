@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// FetchUserProfile fetches the profile for userID from baseURL, racing the
+// HTTP round trip against ctx and the client's read deadline.
+func (c *Client) FetchUserProfile(ctx context.Context, baseURL, userID string) (*http.Response, error) {
+	url := baseURL + "/api/users/" + userID
+	return c.doWithRetry(ctx, c.readCancel(), func(ctx context.Context) (*http.Response, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+		return c.httpClient.Do(req)
+	})
+}
+
+// NotifyService posts a notification to endpoint, racing the HTTP round
+// trip against ctx and the client's write deadline.
+func (c *Client) NotifyService(ctx context.Context, endpoint string) error {
+	url := endpoint + "/notify"
+	resp, err := c.doWithRetry(ctx, c.writeCancel(), func(ctx context.Context) (*http.Response, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		return c.httpClient.Do(req)
+	})
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// doWithRetry executes do, retrying up to c.MaxRetries times with
+// exponential backoff whenever do returns a 5xx response. If every
+// attempt ends in a 5xx, it returns ErrRetriesExhausted rather than the
+// last (already-closed) response, so callers can't mistake an exhausted
+// retry budget for success.
+func (c *Client) doWithRetry(ctx context.Context, cancelCh chan struct{}, do func(context.Context) (*http.Response, error)) (*http.Response, error) {
+	var resp *http.Response
+	var err error
+
+	for attempt := 0; attempt <= c.MaxRetries; attempt++ {
+		if attempt > 0 {
+			backoff := c.RetryBase << (attempt - 1)
+			timer := time.NewTimer(backoff)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, fmt.Errorf("%w: %v", ErrCanceled, ctx.Err())
+			case <-cancelCh:
+				timer.Stop()
+				return nil, ErrTimeout
+			}
+		}
+
+		resp, err = c.race(ctx, cancelCh, do)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode < http.StatusInternalServerError {
+			return resp, nil
+		}
+		if attempt < c.MaxRetries {
+			// This attempt is about to be discarded in favor of a retry;
+			// drain and close its body now rather than leaking the
+			// connection.
+			resp.Body.Close()
+		}
+	}
+
+	status := resp.StatusCode
+	resp.Body.Close()
+	return nil, fmt.Errorf("%w: last status %d", ErrRetriesExhausted, status)
+}
+
+// race runs do on its own goroutine, against a context it cancels as soon
+// as ctx, cancelCh, or do itself settles, and returns whichever of those
+// happens first. Canceling on every exit path ensures a losing request is
+// actually aborted instead of running to completion in the background,
+// and any response body not handed back to the caller is closed so its
+// connection isn't leaked.
+func (c *Client) race(ctx context.Context, cancelCh chan struct{}, do func(context.Context) (*http.Response, error)) (*http.Response, error) {
+	type result struct {
+		resp *http.Response
+		err  error
+	}
+
+	reqCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	done := make(chan result, 1)
+	go func() {
+		resp, err := do(reqCtx)
+		done <- result{resp, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.resp, r.err
+	case <-ctx.Done():
+		cancel()
+		if r := <-done; r.resp != nil {
+			r.resp.Body.Close()
+		}
+		return nil, fmt.Errorf("%w: %v", ErrCanceled, ctx.Err())
+	case <-cancelCh:
+		cancel()
+		if r := <-done; r.resp != nil {
+			r.resp.Body.Close()
+		}
+		return nil, ErrTimeout
+	}
+}
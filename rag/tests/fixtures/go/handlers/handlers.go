@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/example/auth-service/rag/tests/fixtures/go/cache"
+	"github.com/example/auth-service/rag/tests/fixtures/go/models"
+)
+
+// userCacheTTL bounds how long a user lookup may be served from cache.
+const userCacheTTL = 5 * time.Minute
+
+// Handlers holds the dependencies shared by the HTTP handlers in this
+// package.
+type Handlers struct {
+	Cache cache.Cache
+	Repo  models.UserRepository
+}
+
+// NewHandlers returns Handlers backed by c and repo. Pass cache.NoopCache{}
+// to disable caching and models.NewMemoryRepository() for tests.
+func NewHandlers(c cache.Cache, repo models.UserRepository) *Handlers {
+	return &Handlers{Cache: c, Repo: repo}
+}
+
+// GetUser retrieves a user by ID, serving from cache when possible and
+// falling back to the repository on a miss.
+func (h *Handlers) GetUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID := r.URL.Query().Get("id")
+
+	cacheKey := "user:" + userID
+	user, hit, err := cache.GetJSON[models.User](ctx, h.Cache, cacheKey)
+	if err != nil {
+		log.Printf("user cache lookup failed for %s: %v", userID, err)
+		hit = false
+	}
+	if !hit {
+		fetched, err := h.Repo.FindByID(ctx, userID)
+		if errors.Is(err, models.ErrNotFound) {
+			http.Error(w, "user not found", http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			http.Error(w, "repository error", http.StatusInternalServerError)
+			return
+		}
+		user = *fetched
+
+		if err := cache.SetJSON(ctx, h.Cache, cacheKey, user, userCacheTTL); err != nil {
+			log.Printf("user cache write failed for %s: %v", userID, err)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(user)
+}
+
+// CreateUser creates a new user and persists it to the repository. It
+// rejects requests with no ID and requests whose ID is already in use,
+// via Repo.Create, which fails atomically rather than racing a separate
+// FindByID check against a concurrent CreateUser for the same ID.
+func (h *Handlers) CreateUser(w http.ResponseWriter, r *http.Request) {
+	var user models.User
+	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	if user.ID == "" {
+		http.Error(w, "missing user ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	if err := h.Repo.Create(ctx, &user); err != nil {
+		if errors.Is(err, models.ErrAlreadyExists) {
+			http.Error(w, "user already exists", http.StatusConflict)
+			return
+		}
+		http.Error(w, "repository error", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
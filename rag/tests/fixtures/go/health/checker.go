@@ -0,0 +1,23 @@
+package health
+
+import "context"
+
+// SystemChecker performs a single health check.
+type SystemChecker interface {
+	// Execute runs the check, returning a non-nil error if the checked
+	// system is unhealthy.
+	Execute(ctx context.Context) error
+}
+
+// Factory builds a SystemChecker from the params declared for one
+// CheckSpec.
+type Factory interface {
+	New(params map[string]any) (SystemChecker, error)
+}
+
+// FactoryFunc adapts a plain function to a Factory.
+type FactoryFunc func(params map[string]any) (SystemChecker, error)
+
+func (f FactoryFunc) New(params map[string]any) (SystemChecker, error) {
+	return f(params)
+}
@@ -0,0 +1,42 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// dbPingChecker checks a SQL database with "SELECT 1".
+type dbPingChecker struct {
+	db *sql.DB
+}
+
+func (c *dbPingChecker) Execute(ctx context.Context) error {
+	var one int
+	if err := c.db.QueryRowContext(ctx, "SELECT 1").Scan(&one); err != nil {
+		return err
+	}
+	if one != 1 {
+		return fmt.Errorf("health: SELECT 1 returned %d", one)
+	}
+	return nil
+}
+
+// NewDBPingChecker returns a SystemChecker that runs "SELECT 1" against
+// db.
+func NewDBPingChecker(db *sql.DB) SystemChecker {
+	return &dbPingChecker{db: db}
+}
+
+func init() {
+	err := DefaultModule.Register("db_ping", FactoryFunc(func(params map[string]any) (SystemChecker, error) {
+		db, ok := params["db"].(*sql.DB)
+		if !ok {
+			return nil, fmt.Errorf("health: db_ping requires a \"db\" param of type *sql.DB")
+		}
+		return NewDBPingChecker(db), nil
+	}))
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,50 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// httpGetChecker checks a downstream HTTP service with a GET request,
+// treating any non-2xx status as unhealthy.
+type httpGetChecker struct {
+	client *http.Client
+	url    string
+}
+
+func (c *httpGetChecker) Execute(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("health: GET %s returned status %d", c.url, resp.StatusCode)
+	}
+	return nil
+}
+
+// NewHTTPGetChecker returns a SystemChecker that GETs url using client.
+func NewHTTPGetChecker(client *http.Client, url string) SystemChecker {
+	return &httpGetChecker{client: client, url: url}
+}
+
+func init() {
+	err := DefaultModule.Register("http_get", FactoryFunc(func(params map[string]any) (SystemChecker, error) {
+		url, ok := params["url"].(string)
+		if !ok || url == "" {
+			return nil, fmt.Errorf("health: http_get requires a non-empty \"url\" param")
+		}
+		return NewHTTPGetChecker(http.DefaultClient, url), nil
+	}))
+	if err != nil {
+		panic(err)
+	}
+}
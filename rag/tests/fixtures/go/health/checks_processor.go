@@ -0,0 +1,44 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/example/auth-service/rag/tests/fixtures/go/processor"
+)
+
+// processorRoundTripChecker checks that a processor.Registry can still
+// process a known-good value for a known type.
+type processorRoundTripChecker struct {
+	registry *processor.Registry
+	typeName string
+	value    any
+}
+
+func (c *processorRoundTripChecker) Execute(ctx context.Context) error {
+	_, err := c.registry.Process(ctx, c.typeName, c.value)
+	return err
+}
+
+// NewProcessorRoundTripChecker returns a SystemChecker that processes
+// value as typeName through registry.
+func NewProcessorRoundTripChecker(registry *processor.Registry, typeName string, value any) SystemChecker {
+	return &processorRoundTripChecker{registry: registry, typeName: typeName, value: value}
+}
+
+func init() {
+	err := DefaultModule.Register("processor_round_trip", FactoryFunc(func(params map[string]any) (SystemChecker, error) {
+		typeName, ok := params["type"].(string)
+		if !ok || typeName == "" {
+			return nil, fmt.Errorf("health: processor_round_trip requires a non-empty \"type\" param")
+		}
+		registry := processor.DefaultRegistry
+		if r, ok := params["registry"].(*processor.Registry); ok {
+			registry = r
+		}
+		return NewProcessorRoundTripChecker(registry, typeName, params["value"]), nil
+	}))
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,35 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisPingChecker checks a Redis connection with PING.
+type redisPingChecker struct {
+	client *redis.Client
+}
+
+func (c *redisPingChecker) Execute(ctx context.Context) error {
+	return c.client.Ping(ctx).Err()
+}
+
+// NewRedisPingChecker returns a SystemChecker that pings client.
+func NewRedisPingChecker(client *redis.Client) SystemChecker {
+	return &redisPingChecker{client: client}
+}
+
+func init() {
+	err := DefaultModule.Register("redis_ping", FactoryFunc(func(params map[string]any) (SystemChecker, error) {
+		client, ok := params["client"].(*redis.Client)
+		if !ok {
+			return nil, fmt.Errorf("health: redis_ping requires a \"client\" param of type *redis.Client")
+		}
+		return NewRedisPingChecker(client), nil
+	}))
+	if err != nil {
+		panic(err)
+	}
+}
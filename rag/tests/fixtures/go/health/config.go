@@ -0,0 +1,30 @@
+package health
+
+import "fmt"
+
+// CheckSpec declares a single check to run. Specs are typically decoded
+// from JSON or YAML config using these struct tags, then turned into
+// SystemCheckers with LoadChecks.
+type CheckSpec struct {
+	Name   string         `json:"name" yaml:"name"`
+	Type   string         `json:"type" yaml:"type"`
+	Params map[string]any `json:"params" yaml:"params"`
+}
+
+// LoadChecks builds one SystemChecker per spec, looking up each spec's
+// Type in module.
+func LoadChecks(module *Module, specs []CheckSpec) (map[string]SystemChecker, error) {
+	checkers := make(map[string]SystemChecker, len(specs))
+	for _, spec := range specs {
+		factory, ok := module.Lookup(spec.Type)
+		if !ok {
+			return nil, fmt.Errorf("health: unknown check type %q for check %q", spec.Type, spec.Name)
+		}
+		checker, err := factory.New(spec.Params)
+		if err != nil {
+			return nil, fmt.Errorf("health: building check %q: %w", spec.Name, err)
+		}
+		checkers[spec.Name] = checker
+	}
+	return checkers, nil
+}
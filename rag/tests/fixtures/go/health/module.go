@@ -0,0 +1,53 @@
+package health
+
+import (
+	"fmt"
+	"sync"
+)
+
+// ErrConflictingCheck is returned by Module.Register when a Factory is
+// already registered under the same check type name.
+type ErrConflictingCheck struct {
+	Name string
+}
+
+func (e *ErrConflictingCheck) Error() string {
+	return fmt.Sprintf("health: check type %q is already registered", e.Name)
+}
+
+// Module is a registry of check Factories keyed by check type name, e.g.
+// "redis_ping" or "http_get".
+type Module struct {
+	mu        sync.RWMutex
+	factories map[string]Factory
+}
+
+// NewModule returns an empty Module ready for use.
+func NewModule() *Module {
+	return &Module{factories: make(map[string]Factory)}
+}
+
+// Register adds f under name. It returns an *ErrConflictingCheck if a
+// factory is already registered under name.
+func (m *Module) Register(name string, f Factory) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.factories[name]; exists {
+		return &ErrConflictingCheck{Name: name}
+	}
+	m.factories[name] = f
+	return nil
+}
+
+// Lookup returns the factory registered under name, if any.
+func (m *Module) Lookup(name string) (Factory, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	f, ok := m.factories[name]
+	return f, ok
+}
+
+// DefaultModule is prepopulated with the built-in check types: see
+// checks_redis.go, checks_http.go, checks_db.go and checks_processor.go.
+var DefaultModule = NewModule()
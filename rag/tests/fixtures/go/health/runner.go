@@ -0,0 +1,63 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Result is the outcome of a single check execution.
+type Result struct {
+	Name    string        `json:"name"`
+	Status  string        `json:"status"`
+	Latency time.Duration `json:"latency"`
+	Error   string        `json:"error,omitempty"`
+}
+
+// Runner executes a fixed set of named checks concurrently against a
+// shared deadline.
+type Runner struct {
+	Checks map[string]SystemChecker
+}
+
+// NewRunner returns a Runner over checks.
+func NewRunner(checks map[string]SystemChecker) *Runner {
+	return &Runner{Checks: checks}
+}
+
+// Run executes every check concurrently, each bound by ctx, and returns
+// one Result per check. A check that doesn't finish before ctx's deadline
+// is reported with ctx.Err() as its error.
+func (r *Runner) Run(ctx context.Context) []Result {
+	results := make([]Result, len(r.Checks))
+
+	var wg sync.WaitGroup
+	i := 0
+	for name, checker := range r.Checks {
+		wg.Add(1)
+		go func(i int, name string, checker SystemChecker) {
+			defer wg.Done()
+
+			start := time.Now()
+			errCh := make(chan error, 1)
+			go func() { errCh <- checker.Execute(ctx) }()
+
+			var err error
+			select {
+			case err = <-errCh:
+			case <-ctx.Done():
+				err = ctx.Err()
+			}
+			res := Result{Name: name, Status: "ok", Latency: time.Since(start)}
+			if err != nil {
+				res.Status = "error"
+				res.Error = err.Error()
+			}
+			results[i] = res
+		}(i, name, checker)
+		i++
+	}
+	wg.Wait()
+
+	return results
+}
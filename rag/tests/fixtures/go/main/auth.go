@@ -0,0 +1,24 @@
+package main
+
+import "net/http"
+
+// requireUser wraps next so it only runs once the caller has been
+// authenticated and WithUser has populated the request's context. It
+// authenticates by reading the X-User-ID/X-Username headers set by
+// trusted upstream infra (e.g. an API gateway or sidecar) terminating
+// the real auth check; it is not itself a token or session verifier.
+func (s *Service) requireUser(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get("X-User-ID")
+		if id == "" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		user := User{
+			ID:       id,
+			Username: r.Header.Get("X-Username"),
+		}
+		next(w, r.WithContext(WithUser(r.Context(), user)))
+	}
+}
@@ -0,0 +1,27 @@
+package main
+
+import "github.com/example/auth-service/rag/tests/fixtures/go/health"
+
+// Config holds application configuration
+type Config struct {
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Debug    bool   `json:"debug"`
+	Timeout  int    `json:"timeout"`
+	MaxConns int    `json:"max_conns"`
+
+	RedisAddr     string `json:"redis_addr"`
+	RedisPassword string `json:"redis_password"`
+	RedisDB       int    `json:"redis_db"`
+	RedisPoolSize int    `json:"redis_pool_size"`
+
+	// Storage selects the UserRepository backend: "postgres", "redis"
+	// or "memory".
+	Storage     string `json:"storage"`
+	PostgresDSN string `json:"postgres_dsn"`
+
+	// Checks declares additional health checks to build via
+	// health.LoadChecks and register alongside the built-in "redis" and
+	// "storage" checks ConnectRedis/ConnectStorage set up.
+	Checks []health.CheckSpec `json:"checks"`
+}
@@ -0,0 +1,18 @@
+package main
+
+import "context"
+
+// userKey is an unexported type so values stored under it can't collide
+// with context keys set by other packages.
+type userKey struct{}
+
+// WithUser returns a copy of ctx carrying user.
+func WithUser(ctx context.Context, user User) context.Context {
+	return context.WithValue(ctx, userKey{}, user)
+}
+
+// UserFromContext returns the User stored in ctx by WithUser, if any.
+func UserFromContext(ctx context.Context) (User, bool) {
+	user, ok := ctx.Value(userKey{}).(User)
+	return user, ok
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/example/auth-service/rag/tests/fixtures/go/cache"
+)
+
+// statusCacheTTL bounds how long a computed status response may be served
+// from cache before HandleRequest recomputes it.
+const statusCacheTTL = 30 * time.Second
+
+// HandleRequest processes incoming HTTP requests
+func (s *Service) HandleRequest(w http.ResponseWriter, r *http.Request) {
+	// Check if request is nil
+	if r == nil {
+		http.Error(w, "nil request", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), time.Duration(s.config.Timeout)*time.Second)
+	defer cancel()
+
+	// Get user from context
+	user, ok := UserFromContext(ctx)
+	if !ok {
+		log.Printf("user not found in context")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	cacheKey := "status:" + user.ID
+	if cached, hit, err := cache.GetJSON[map[string]any](ctx, s.cache, cacheKey); err != nil {
+		log.Printf("status cache lookup failed for %s: %v", user.ID, err)
+	} else if hit {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(cached)
+		return
+	}
+
+	// Process the request
+	result := map[string]any{
+		"status": "ok",
+		"user":   user.Username,
+		"admin":  false,
+		"master": false,
+	}
+
+	// Handle null/nil cases
+	if user.ID == "" {
+		result["status"] = "error"
+		result["error"] = "null user ID"
+	}
+
+	if err := cache.SetJSON(ctx, s.cache, cacheKey, result, statusCacheTTL); err != nil {
+		log.Printf("status cache write failed for %s: %v", user.ID, err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/example/auth-service/rag/tests/fixtures/go/health"
+)
+
+// healthCheckTimeout bounds how long Healthz/Readyz wait for every
+// registered check to finish.
+const healthCheckTimeout = 5 * time.Second
+
+type healthResponse struct {
+	Status string          `json:"status"`
+	Checks []health.Result `json:"checks"`
+}
+
+// runChecks executes every registered check under a shared deadline and
+// writes an aggregate JSON response.
+func (s *Service) runChecks(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), healthCheckTimeout)
+	defer cancel()
+
+	results := health.NewRunner(s.checks).Run(ctx)
+
+	status := http.StatusOK
+	overall := "ok"
+	for _, res := range results {
+		if res.Status != "ok" {
+			status = http.StatusServiceUnavailable
+			overall = "error"
+			break
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(healthResponse{Status: overall, Checks: results})
+}
+
+// Healthz reports whether the service's dependencies are reachable.
+func (s *Service) Healthz(w http.ResponseWriter, r *http.Request) {
+	s.runChecks(w, r)
+}
+
+// Readyz reports whether the service is ready to accept traffic. It runs
+// the same checks as Healthz; services that need a narrower readiness
+// signal can register a different check set under a dedicated Service.
+func (s *Service) Readyz(w http.ResponseWriter, r *http.Request) {
+	s.runChecks(w, r)
+}
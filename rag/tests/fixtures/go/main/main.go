@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/example/auth-service/rag/tests/fixtures/go/handlers"
+)
+
+func main() {
+	cfg := Config{
+		Host:     "0.0.0.0",
+		Port:     8080,
+		Debug:    true,
+		Timeout:  30,
+		MaxConns: 100,
+		Storage:  "memory",
+	}
+
+	svc := NewService(cfg)
+
+	ctx := context.Background()
+	if err := svc.ConnectRedis(ctx); err != nil {
+		log.Fatalf("Failed to connect to Redis: %v", err)
+	}
+	if err := svc.ConnectStorage(ctx); err != nil {
+		log.Fatalf("Failed to connect to storage: %v", err)
+	}
+	if err := svc.LoadChecks(); err != nil {
+		log.Fatalf("Failed to load checks: %v", err)
+	}
+
+	h := handlers.NewHandlers(svc.Cache(), svc.Repo())
+
+	http.HandleFunc("/api/v1/status", svc.requireUser(svc.HandleRequest))
+	http.HandleFunc("/healthz", svc.Healthz)
+	http.HandleFunc("/readyz", svc.Readyz)
+	http.HandleFunc("/api/v1/users", h.CreateUser)
+	http.HandleFunc("/api/v1/user", h.GetUser)
+
+	addr := fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
+	log.Printf("Starting server on %s", addr)
+	log.Fatal(http.ListenAndServe(addr, nil))
+}
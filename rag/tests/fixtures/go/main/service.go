@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/example/auth-service/rag/tests/fixtures/go/cache"
+	"github.com/example/auth-service/rag/tests/fixtures/go/health"
+	"github.com/example/auth-service/rag/tests/fixtures/go/models"
+)
+
+// User represents a user in the system
+type User struct {
+	ID        string    `json:"id"`
+	Username  string    `json:"username"`
+	CreatedAt time.Time `json:"created_at"`
+	Active    bool      `json:"active"`
+}
+
+// Service handles business logic
+type Service struct {
+	config      Config
+	cache       cache.Cache
+	checks      map[string]health.SystemChecker
+	repo        models.UserRepository
+	redisClient *redis.Client // set by ConnectRedis; reused by ConnectStorage for Storage=="redis"
+}
+
+// NewService creates a new service instance
+func NewService(cfg Config) *Service {
+	return &Service{
+		config: cfg,
+		cache:  cache.NoopCache{},
+		checks: make(map[string]health.SystemChecker),
+	}
+}
+
+// Cache returns the cache backing this service, for wiring into other
+// packages (e.g. handlers.NewHandlers).
+func (s *Service) Cache() cache.Cache {
+	return s.cache
+}
+
+// Repo returns the user repository backing this service, for wiring into
+// other packages (e.g. handlers.NewHandlers).
+func (s *Service) Repo() models.UserRepository {
+	return s.repo
+}
+
+// RegisterCheck adds a named health check that Healthz/Readyz will run.
+// Registering under a name that's already in use replaces the existing
+// check.
+func (s *Service) RegisterCheck(name string, checker health.SystemChecker) {
+	s.checks[name] = checker
+}
+
+// LoadChecks builds the health checks declared in s.config.Checks against
+// health.DefaultModule and registers each one under its configured name,
+// alongside the built-in checks ConnectRedis/ConnectStorage set up.
+func (s *Service) LoadChecks() error {
+	checks, err := health.LoadChecks(health.DefaultModule, s.config.Checks)
+	if err != nil {
+		return fmt.Errorf("load checks: %w", err)
+	}
+	for name, checker := range checks {
+		s.RegisterCheck(name, checker)
+	}
+	return nil
+}
+
+// ConnectRedis establishes the Redis connection backing s.cache and
+// registers a "redis" health check against it.
+func (s *Service) ConnectRedis(ctx context.Context) error {
+	client := redis.NewClient(&redis.Options{
+		Addr:     s.config.RedisAddr,
+		Password: s.config.RedisPassword,
+		DB:       s.config.RedisDB,
+		PoolSize: s.config.RedisPoolSize,
+	})
+
+	// Ping to verify connection
+	if _, err := client.Ping(ctx).Result(); err != nil {
+		return fmt.Errorf("redis connection failed: %w", err)
+	}
+
+	s.cache = cache.NewRedisCache(client)
+	s.redisClient = client
+	s.RegisterCheck("redis", health.NewRedisPingChecker(client))
+	log.Printf("Connected to Redis at %s", s.config.RedisAddr)
+	return nil
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/example/auth-service/rag/tests/fixtures/go/health"
+	"github.com/example/auth-service/rag/tests/fixtures/go/models"
+	"github.com/example/auth-service/rag/tests/fixtures/go/models/postgres"
+	modelsredis "github.com/example/auth-service/rag/tests/fixtures/go/models/redis"
+)
+
+// newRepository builds the UserRepository selected by storage, one of
+// "postgres", "redis" or "memory". It lives here rather than in the
+// models package to avoid models importing its own postgres/redis
+// subpackages.
+func newRepository(storage string, db *sql.DB, redisClient *redis.Client) (models.UserRepository, error) {
+	switch storage {
+	case "postgres":
+		if db == nil {
+			return nil, fmt.Errorf("storage: postgres storage requires a *sql.DB")
+		}
+		return postgres.New(db), nil
+	case "redis":
+		if redisClient == nil {
+			return nil, fmt.Errorf("storage: redis storage requires a *redis.Client")
+		}
+		return modelsredis.New(redisClient), nil
+	case "memory":
+		return models.NewMemoryRepository(), nil
+	default:
+		return nil, fmt.Errorf("storage: unknown storage backend %q", storage)
+	}
+}
+
+// ConnectStorage builds the UserRepository selected by s.config.Storage
+// and assigns it to s.repo, registering a matching "storage" health
+// check. For "postgres" it also runs EnsureSchema.
+func (s *Service) ConnectStorage(ctx context.Context) error {
+	var db *sql.DB
+	var redisClient *redis.Client
+
+	switch s.config.Storage {
+	case "postgres":
+		var err error
+		db, err = sql.Open("postgres", s.config.PostgresDSN)
+		if err != nil {
+			return fmt.Errorf("storage: open postgres: %w", err)
+		}
+		if err := db.PingContext(ctx); err != nil {
+			return fmt.Errorf("storage: ping postgres: %w", err)
+		}
+		s.RegisterCheck("storage", health.NewDBPingChecker(db))
+	case "redis":
+		redisClient = s.redisClient
+		if redisClient == nil {
+			redisClient = redis.NewClient(&redis.Options{
+				Addr:     s.config.RedisAddr,
+				Password: s.config.RedisPassword,
+				DB:       s.config.RedisDB,
+				PoolSize: s.config.RedisPoolSize,
+			})
+		}
+		if _, err := redisClient.Ping(ctx).Result(); err != nil {
+			return fmt.Errorf("storage: ping redis: %w", err)
+		}
+		s.RegisterCheck("storage", health.NewRedisPingChecker(redisClient))
+	}
+
+	repo, err := newRepository(s.config.Storage, db, redisClient)
+	if err != nil {
+		return fmt.Errorf("storage: build repository: %w", err)
+	}
+
+	if pg, ok := repo.(*postgres.Repository); ok {
+		if err := pg.EnsureSchema(ctx); err != nil {
+			return fmt.Errorf("storage: ensure schema: %w", err)
+		}
+	}
+
+	s.repo = repo
+	return nil
+}
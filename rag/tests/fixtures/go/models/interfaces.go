@@ -0,0 +1,29 @@
+package models
+
+import "context"
+
+// UserRepository defines the interface for user persistence. Concrete
+// implementations live in the postgres and redis subpackages, plus an
+// in-memory one (NewMemoryRepository) for tests.
+type UserRepository interface {
+	FindByID(ctx context.Context, id string) (*User, error)
+
+	// Create atomically persists user, failing with ErrAlreadyExists if a
+	// user with the same ID already exists. Unlike Save, it never
+	// overwrites an existing record.
+	Create(ctx context.Context, user *User) error
+
+	// Save persists user, creating it if it doesn't already exist or
+	// overwriting it if it does.
+	Save(ctx context.Context, user *User) error
+
+	Delete(ctx context.Context, id string) error
+}
+
+// User represents a user entity.
+type User struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	IsActive bool   `json:"is_active"`
+}
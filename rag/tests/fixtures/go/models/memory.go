@@ -0,0 +1,65 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// ErrNotFound is returned by UserRepository.FindByID when no user exists
+// under the given ID.
+var ErrNotFound = fmt.Errorf("models: user not found")
+
+// ErrAlreadyExists is returned by UserRepository.Create when a user with
+// the given ID already exists.
+var ErrAlreadyExists = fmt.Errorf("models: user already exists")
+
+// memoryRepository is an in-memory UserRepository, intended for tests and
+// local development.
+type memoryRepository struct {
+	mu    sync.RWMutex
+	users map[string]User
+}
+
+// NewMemoryRepository returns an empty in-memory UserRepository.
+func NewMemoryRepository() UserRepository {
+	return &memoryRepository{users: make(map[string]User)}
+}
+
+func (r *memoryRepository) FindByID(ctx context.Context, id string) (*User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	user, ok := r.users[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &user, nil
+}
+
+func (r *memoryRepository) Create(ctx context.Context, user *User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.users[user.ID]; ok {
+		return ErrAlreadyExists
+	}
+	r.users[user.ID] = *user
+	return nil
+}
+
+func (r *memoryRepository) Save(ctx context.Context, user *User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.users[user.ID] = *user
+	return nil
+}
+
+func (r *memoryRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.users, id)
+	return nil
+}
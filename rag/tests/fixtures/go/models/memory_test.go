@@ -0,0 +1,14 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/example/auth-service/rag/tests/fixtures/go/models"
+	"github.com/example/auth-service/rag/tests/fixtures/go/models/modeltest"
+)
+
+func TestMemoryRepositoryConformance(t *testing.T) {
+	modeltest.RunConformance(t, func() models.UserRepository {
+		return models.NewMemoryRepository()
+	})
+}
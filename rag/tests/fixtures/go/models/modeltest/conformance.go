@@ -0,0 +1,120 @@
+// Package modeltest holds a table-driven conformance suite that every
+// models.UserRepository implementation is expected to pass. Run it from a
+// _test.go file in each backend's package, e.g.:
+//
+//	func TestMemoryRepositoryConformance(t *testing.T) {
+//		modeltest.RunConformance(t, func() models.UserRepository {
+//			return models.NewMemoryRepository()
+//		})
+//	}
+package modeltest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/example/auth-service/rag/tests/fixtures/go/models"
+)
+
+// RunConformance runs the shared UserRepository test suite against a
+// fresh repository produced by newRepo for each subtest.
+func RunConformance(t *testing.T, newRepo func() models.UserRepository) {
+	t.Helper()
+
+	t.Run("FindByIDNotFound", func(t *testing.T) {
+		repo := newRepo()
+		ctx := context.Background()
+
+		_, err := repo.FindByID(ctx, "missing")
+		if !errors.Is(err, models.ErrNotFound) {
+			t.Fatalf("FindByID(missing) = %v, want models.ErrNotFound", err)
+		}
+	})
+
+	t.Run("SaveThenFindByID", func(t *testing.T) {
+		repo := newRepo()
+		ctx := context.Background()
+		want := &models.User{ID: "u1", Name: "Ada", Email: "ada@example.com", IsActive: true}
+
+		if err := repo.Save(ctx, want); err != nil {
+			t.Fatalf("Save(%+v) = %v, want nil", want, err)
+		}
+
+		got, err := repo.FindByID(ctx, want.ID)
+		if err != nil {
+			t.Fatalf("FindByID(%q) = %v, want nil", want.ID, err)
+		}
+		if *got != *want {
+			t.Errorf("FindByID(%q) = %+v, want %+v", want.ID, got, want)
+		}
+	})
+
+	t.Run("CreateRejectsDuplicateID", func(t *testing.T) {
+		repo := newRepo()
+		ctx := context.Background()
+		want := &models.User{ID: "u1", Name: "Ada", Email: "ada@example.com", IsActive: true}
+
+		if err := repo.Create(ctx, want); err != nil {
+			t.Fatalf("Create(first) = %v, want nil", err)
+		}
+
+		err := repo.Create(ctx, &models.User{ID: "u1", Name: "Someone Else"})
+		if !errors.Is(err, models.ErrAlreadyExists) {
+			t.Fatalf("Create(duplicate) = %v, want models.ErrAlreadyExists", err)
+		}
+
+		got, err := repo.FindByID(ctx, "u1")
+		if err != nil {
+			t.Fatalf("FindByID(u1) = %v, want nil", err)
+		}
+		if *got != *want {
+			t.Errorf("FindByID(u1) = %+v, want %+v (Create(duplicate) must not overwrite)", got, want)
+		}
+	})
+
+	t.Run("SaveOverwritesExisting", func(t *testing.T) {
+		repo := newRepo()
+		ctx := context.Background()
+
+		if err := repo.Save(ctx, &models.User{ID: "u1", Name: "Ada"}); err != nil {
+			t.Fatalf("Save(first) = %v, want nil", err)
+		}
+		updated := &models.User{ID: "u1", Name: "Ada Lovelace"}
+		if err := repo.Save(ctx, updated); err != nil {
+			t.Fatalf("Save(second) = %v, want nil", err)
+		}
+
+		got, err := repo.FindByID(ctx, "u1")
+		if err != nil {
+			t.Fatalf("FindByID(u1) = %v, want nil", err)
+		}
+		if got.Name != "Ada Lovelace" {
+			t.Errorf("FindByID(u1).Name = %q, want %q", got.Name, "Ada Lovelace")
+		}
+	})
+
+	t.Run("DeleteRemovesUser", func(t *testing.T) {
+		repo := newRepo()
+		ctx := context.Background()
+
+		if err := repo.Save(ctx, &models.User{ID: "u1", Name: "Ada"}); err != nil {
+			t.Fatalf("Save = %v, want nil", err)
+		}
+		if err := repo.Delete(ctx, "u1"); err != nil {
+			t.Fatalf("Delete(u1) = %v, want nil", err)
+		}
+
+		_, err := repo.FindByID(ctx, "u1")
+		if !errors.Is(err, models.ErrNotFound) {
+			t.Fatalf("FindByID(u1) after Delete = %v, want models.ErrNotFound", err)
+		}
+	})
+
+	t.Run("DeleteMissingIsNoop", func(t *testing.T) {
+		repo := newRepo()
+		if err := repo.Delete(context.Background(), "missing"); err != nil {
+			t.Fatalf("Delete(missing) = %v, want nil", err)
+		}
+	})
+}
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	_ "github.com/lib/pq"
+
+	"github.com/example/auth-service/rag/tests/fixtures/go/models"
+)
+
+// Repository is a models.UserRepository backed by Postgres.
+type Repository struct {
+	db *sql.DB
+}
+
+// New wraps db as a models.UserRepository. Callers are responsible for
+// opening db with sql.Open("postgres", dsn) and for calling EnsureSchema
+// before first use.
+func New(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// EnsureSchema creates the users table if it does not already exist. See
+// migrations.sql for the full migration this mirrors.
+func (r *Repository) EnsureSchema(ctx context.Context) error {
+	_, err := r.db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS users (
+			id        TEXT PRIMARY KEY,
+			name      TEXT NOT NULL,
+			email     TEXT NOT NULL,
+			is_active BOOLEAN NOT NULL DEFAULT true
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("postgres: ensure schema: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) FindByID(ctx context.Context, id string) (*models.User, error) {
+	var user models.User
+	err := r.db.QueryRowContext(ctx,
+		`SELECT id, name, email, is_active FROM users WHERE id = $1`, id,
+	).Scan(&user.ID, &user.Name, &user.Email, &user.IsActive)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, models.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("postgres: find user %q: %w", id, err)
+	}
+	return &user, nil
+}
+
+func (r *Repository) Create(ctx context.Context, user *models.User) error {
+	res, err := r.db.ExecContext(ctx, `
+		INSERT INTO users (id, name, email, is_active)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (id) DO NOTHING
+	`, user.ID, user.Name, user.Email, user.IsActive)
+	if err != nil {
+		return fmt.Errorf("postgres: create user %q: %w", user.ID, err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("postgres: create user %q: %w", user.ID, err)
+	}
+	if rows == 0 {
+		return models.ErrAlreadyExists
+	}
+	return nil
+}
+
+func (r *Repository) Save(ctx context.Context, user *models.User) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO users (id, name, email, is_active)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (id) DO UPDATE
+		SET name = EXCLUDED.name, email = EXCLUDED.email, is_active = EXCLUDED.is_active
+	`, user.ID, user.Name, user.Email, user.IsActive)
+	if err != nil {
+		return fmt.Errorf("postgres: save user %q: %w", user.ID, err)
+	}
+	return nil
+}
+
+func (r *Repository) Delete(ctx context.Context, id string) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM users WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("postgres: delete user %q: %w", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	_ "github.com/lib/pq"
+
+	"github.com/example/auth-service/rag/tests/fixtures/go/models"
+	"github.com/example/auth-service/rag/tests/fixtures/go/models/modeltest"
+	"github.com/example/auth-service/rag/tests/fixtures/go/models/postgres"
+)
+
+// TestRepositoryConformance runs the shared UserRepository suite against a
+// real Postgres instance. It requires the "integration" build tag and a
+// running Postgres reachable via POSTGRES_TEST_DSN, e.g.:
+//
+//	POSTGRES_TEST_DSN="postgres://postgres@localhost/postgres?sslmode=disable" \
+//		go test -tags=integration ./models/postgres/...
+func TestRepositoryConformance(t *testing.T) {
+	dsn := os.Getenv("POSTGRES_TEST_DSN")
+	if dsn == "" {
+		t.Skip("POSTGRES_TEST_DSN not set; skipping Postgres integration test")
+	}
+
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatalf("open postgres: %v", err)
+	}
+	defer db.Close()
+
+	repo := postgres.New(db)
+	if err := repo.EnsureSchema(context.Background()); err != nil {
+		t.Fatalf("EnsureSchema: %v", err)
+	}
+
+	modeltest.RunConformance(t, func() models.UserRepository {
+		if _, err := db.Exec(`TRUNCATE TABLE users`); err != nil {
+			t.Fatalf("truncate users: %v", err)
+		}
+		return repo
+	})
+}
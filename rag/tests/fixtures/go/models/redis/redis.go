@@ -0,0 +1,76 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"github.com/example/auth-service/rag/tests/fixtures/go/models"
+)
+
+// keyPrefix namespaces user records within whatever keyspace the client
+// is also used for (sessions, locks, etc).
+const keyPrefix = "user:"
+
+// Repository is a models.UserRepository backed by Redis, storing each
+// User as a JSON blob.
+type Repository struct {
+	client *goredis.Client
+}
+
+// New wraps client as a models.UserRepository.
+func New(client *goredis.Client) *Repository {
+	return &Repository{client: client}
+}
+
+func (r *Repository) FindByID(ctx context.Context, id string) (*models.User, error) {
+	raw, err := r.client.Get(ctx, keyPrefix+id).Result()
+	if errors.Is(err, goredis.Nil) {
+		return nil, models.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("redis: find user %q: %w", id, err)
+	}
+
+	var user models.User
+	if err := json.Unmarshal([]byte(raw), &user); err != nil {
+		return nil, fmt.Errorf("redis: unmarshal user %q: %w", id, err)
+	}
+	return &user, nil
+}
+
+func (r *Repository) Create(ctx context.Context, user *models.User) error {
+	raw, err := json.Marshal(user)
+	if err != nil {
+		return fmt.Errorf("redis: marshal user %q: %w", user.ID, err)
+	}
+	ok, err := r.client.SetNX(ctx, keyPrefix+user.ID, raw, 0).Result()
+	if err != nil {
+		return fmt.Errorf("redis: create user %q: %w", user.ID, err)
+	}
+	if !ok {
+		return models.ErrAlreadyExists
+	}
+	return nil
+}
+
+func (r *Repository) Save(ctx context.Context, user *models.User) error {
+	raw, err := json.Marshal(user)
+	if err != nil {
+		return fmt.Errorf("redis: marshal user %q: %w", user.ID, err)
+	}
+	if err := r.client.Set(ctx, keyPrefix+user.ID, raw, 0).Err(); err != nil {
+		return fmt.Errorf("redis: save user %q: %w", user.ID, err)
+	}
+	return nil
+}
+
+func (r *Repository) Delete(ctx context.Context, id string) error {
+	if err := r.client.Del(ctx, keyPrefix+id).Err(); err != nil {
+		return fmt.Errorf("redis: delete user %q: %w", id, err)
+	}
+	return nil
+}
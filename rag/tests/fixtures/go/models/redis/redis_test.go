@@ -0,0 +1,32 @@
+package redis_test
+
+import (
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	goredis "github.com/redis/go-redis/v9"
+
+	"github.com/example/auth-service/rag/tests/fixtures/go/models"
+	"github.com/example/auth-service/rag/tests/fixtures/go/models/modeltest"
+	modelsredis "github.com/example/auth-service/rag/tests/fixtures/go/models/redis"
+)
+
+// TestRepositoryConformance runs the shared UserRepository suite against a
+// miniredis instance, a fake in-process implementation of the Redis
+// protocol, so it exercises the real Repository code without needing a
+// live Redis server.
+func TestRepositoryConformance(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("start miniredis: %v", err)
+	}
+	defer mr.Close()
+
+	client := goredis.NewClient(&goredis.Options{Addr: mr.Addr()})
+	defer client.Close()
+
+	modeltest.RunConformance(t, func() models.UserRepository {
+		mr.FlushAll()
+		return modelsredis.New(client)
+	})
+}
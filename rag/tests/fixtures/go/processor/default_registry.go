@@ -0,0 +1,37 @@
+package processor
+
+// DefaultRegistry is prepopulated with a TypeProcessor for every builtin
+// type ProcessLargeData used to handle inline. Callers that need
+// additional types should build their own Registry and Register onto it
+// instead of mutating DefaultRegistry, unless they specifically want to
+// extend the shared default.
+var DefaultRegistry = NewRegistry()
+
+func init() {
+	builtins := []TypeProcessor{
+		stringProcessor{},
+		integerProcessor{},
+		floatProcessor{},
+		booleanProcessor{},
+		arrayProcessor{},
+		mapProcessor{},
+		nullProcessor{},
+		timestampProcessor{},
+		uuidProcessor{},
+		emailProcessor{},
+		urlProcessor{},
+		jsonProcessor{},
+		binaryProcessor{},
+		coordinatesProcessor{},
+		currencyProcessor{},
+		percentageProcessor{},
+		phoneProcessor{},
+		addressProcessor{},
+		statusProcessor{},
+	}
+	for _, p := range builtins {
+		if err := DefaultRegistry.Register(p); err != nil {
+			panic(err)
+		}
+	}
+}
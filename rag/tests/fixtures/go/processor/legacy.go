@@ -0,0 +1,17 @@
+package processor
+
+import "context"
+
+// ProcessLargeData processes value as dataType using the DefaultRegistry.
+//
+// Deprecated: kept as a thin shim over DefaultRegistry.Process so existing
+// callers don't need to migrate immediately. New code should use
+// DefaultRegistry.Process or Lookup a specific TypeProcessor directly.
+func ProcessLargeData(ctx context.Context, dataType string, value any) (string, error) {
+	return DefaultRegistry.Process(ctx, dataType, value)
+}
+
+// AnotherFunction is here to ensure the file has multiple declarations.
+func AnotherFunction(x int) int {
+	return x * 2
+}
@@ -0,0 +1,42 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+)
+
+// TypeProcessor handles validation and processing for a single data type.
+// Implementations are registered with a Registry under the name returned
+// by TypeName.
+type TypeProcessor interface {
+	// TypeName is the registry key this processor handles, e.g. "uuid".
+	TypeName() string
+
+	// Validate reports whether value is an acceptable input for this type.
+	Validate(value any) error
+
+	// Process converts value into its processed string representation.
+	// Callers should Validate first; implementations may re-validate
+	// defensively but are not required to.
+	Process(ctx context.Context, value any) (string, error)
+}
+
+// ErrConflictingType is returned by Registry.Register when a TypeProcessor
+// is already registered under the same TypeName.
+type ErrConflictingType struct {
+	TypeName string
+}
+
+func (e *ErrConflictingType) Error() string {
+	return fmt.Sprintf("processor: type %q is already registered", e.TypeName)
+}
+
+// ErrUnknownType is returned when no TypeProcessor is registered for a
+// requested type name.
+type ErrUnknownType struct {
+	TypeName string
+}
+
+func (e *ErrUnknownType) Error() string {
+	return fmt.Sprintf("processor: unsupported data type: %s", e.TypeName)
+}
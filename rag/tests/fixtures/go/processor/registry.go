@@ -0,0 +1,60 @@
+package processor
+
+import (
+	"context"
+	"sync"
+)
+
+// Registry holds a set of TypeProcessors keyed by TypeName.
+type Registry struct {
+	mu         sync.RWMutex
+	processors map[string]TypeProcessor
+}
+
+// NewRegistry returns an empty Registry ready for use.
+func NewRegistry() *Registry {
+	return &Registry{processors: make(map[string]TypeProcessor)}
+}
+
+// Register adds p to the registry. It returns an *ErrConflictingType if a
+// processor is already registered under p.TypeName().
+func (r *Registry) Register(p TypeProcessor) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	name := p.TypeName()
+	if _, exists := r.processors[name]; exists {
+		return &ErrConflictingType{TypeName: name}
+	}
+	r.processors[name] = p
+	return nil
+}
+
+// Unregister removes the processor registered under name, if any.
+func (r *Registry) Unregister(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.processors, name)
+}
+
+// Lookup returns the processor registered under name, if any.
+func (r *Registry) Lookup(name string) (TypeProcessor, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	p, ok := r.processors[name]
+	return p, ok
+}
+
+// Process validates and processes value using the processor registered
+// under typeName. It returns an *ErrUnknownType if no such processor is
+// registered.
+func (r *Registry) Process(ctx context.Context, typeName string, value any) (string, error) {
+	p, ok := r.Lookup(typeName)
+	if !ok {
+		return "", &ErrUnknownType{TypeName: typeName}
+	}
+	if err := p.Validate(value); err != nil {
+		return "", err
+	}
+	return p.Process(ctx, value)
+}
@@ -0,0 +1,64 @@
+package processor
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestRegistryRegisterConflict(t *testing.T) {
+	r := NewRegistry()
+
+	if err := r.Register(stringProcessor{}); err != nil {
+		t.Fatalf("Register(stringProcessor{}) = %v, want nil", err)
+	}
+
+	err := r.Register(stringProcessor{})
+	var conflict *ErrConflictingType
+	if !errors.As(err, &conflict) {
+		t.Fatalf("Register(stringProcessor{}) again = %v, want *ErrConflictingType", err)
+	}
+	if conflict.TypeName != "string" {
+		t.Errorf("conflict.TypeName = %q, want %q", conflict.TypeName, "string")
+	}
+}
+
+func TestRegistryProcessUnknownType(t *testing.T) {
+	r := NewRegistry()
+
+	_, err := r.Process(context.Background(), "does-not-exist", "value")
+	var unknown *ErrUnknownType
+	if !errors.As(err, &unknown) {
+		t.Fatalf("Process(unknown type) = %v, want *ErrUnknownType", err)
+	}
+	if unknown.TypeName != "does-not-exist" {
+		t.Errorf("unknown.TypeName = %q, want %q", unknown.TypeName, "does-not-exist")
+	}
+}
+
+func TestRegistryProcessValidationError(t *testing.T) {
+	r := NewRegistry()
+	if err := r.Register(integerProcessor{}); err != nil {
+		t.Fatalf("Register(integerProcessor{}) = %v, want nil", err)
+	}
+
+	_, err := r.Process(context.Background(), "integer", "not-an-int")
+	if err == nil {
+		t.Fatal("Process(\"integer\", \"not-an-int\") = nil error, want a validation error")
+	}
+}
+
+func TestRegistryProcessSuccess(t *testing.T) {
+	r := NewRegistry()
+	if err := r.Register(stringProcessor{}); err != nil {
+		t.Fatalf("Register(stringProcessor{}) = %v, want nil", err)
+	}
+
+	got, err := r.Process(context.Background(), "string", "hello")
+	if err != nil {
+		t.Fatalf("Process(\"string\", \"hello\") = %v, want nil", err)
+	}
+	if want := "processed_string:hello"; got != want {
+		t.Errorf("Process(\"string\", \"hello\") = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,22 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+)
+
+// addressProcessor handles the "address" type.
+type addressProcessor struct{}
+
+func (addressProcessor) TypeName() string { return "address" }
+
+func (addressProcessor) Validate(value any) error {
+	if _, ok := value.(map[string]string); !ok {
+		return fmt.Errorf("expected address map, got %T", value)
+	}
+	return nil
+}
+
+func (addressProcessor) Process(ctx context.Context, value any) (string, error) {
+	return fmt.Sprintf("processed_address:fields=%d", len(value.(map[string]string))), nil
+}
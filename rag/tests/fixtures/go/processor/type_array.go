@@ -0,0 +1,22 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+)
+
+// arrayProcessor handles the "array" type.
+type arrayProcessor struct{}
+
+func (arrayProcessor) TypeName() string { return "array" }
+
+func (arrayProcessor) Validate(value any) error {
+	if _, ok := value.([]any); !ok {
+		return fmt.Errorf("expected array, got %T", value)
+	}
+	return nil
+}
+
+func (arrayProcessor) Process(ctx context.Context, value any) (string, error) {
+	return fmt.Sprintf("processed_array:len=%d", len(value.([]any))), nil
+}
@@ -0,0 +1,22 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+)
+
+// binaryProcessor handles the "binary" type.
+type binaryProcessor struct{}
+
+func (binaryProcessor) TypeName() string { return "binary" }
+
+func (binaryProcessor) Validate(value any) error {
+	if _, ok := value.([]byte); !ok {
+		return fmt.Errorf("expected binary, got %T", value)
+	}
+	return nil
+}
+
+func (binaryProcessor) Process(ctx context.Context, value any) (string, error) {
+	return fmt.Sprintf("processed_binary:len=%d", len(value.([]byte))), nil
+}
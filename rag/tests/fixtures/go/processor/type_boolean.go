@@ -0,0 +1,22 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+)
+
+// booleanProcessor handles the "boolean" type.
+type booleanProcessor struct{}
+
+func (booleanProcessor) TypeName() string { return "boolean" }
+
+func (booleanProcessor) Validate(value any) error {
+	if _, ok := value.(bool); !ok {
+		return fmt.Errorf("expected bool, got %T", value)
+	}
+	return nil
+}
+
+func (booleanProcessor) Process(ctx context.Context, value any) (string, error) {
+	return fmt.Sprintf("processed_bool:%v", !value.(bool)), nil
+}
@@ -0,0 +1,27 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+)
+
+// coordinatesProcessor handles the "coordinates" type.
+type coordinatesProcessor struct{}
+
+func (coordinatesProcessor) TypeName() string { return "coordinates" }
+
+func (coordinatesProcessor) Validate(value any) error {
+	coords, ok := value.([]float64)
+	if !ok {
+		return fmt.Errorf("expected coordinates, got %T", value)
+	}
+	if len(coords) != 2 {
+		return fmt.Errorf("expected 2 coordinates, got %d", len(coords))
+	}
+	return nil
+}
+
+func (coordinatesProcessor) Process(ctx context.Context, value any) (string, error) {
+	coords := value.([]float64)
+	return fmt.Sprintf("processed_coords:lat=%.4f,lng=%.4f", coords[0], coords[1]), nil
+}
@@ -0,0 +1,22 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+)
+
+// currencyProcessor handles the "currency" type.
+type currencyProcessor struct{}
+
+func (currencyProcessor) TypeName() string { return "currency" }
+
+func (currencyProcessor) Validate(value any) error {
+	if _, ok := value.(float64); !ok {
+		return fmt.Errorf("expected currency amount, got %T", value)
+	}
+	return nil
+}
+
+func (currencyProcessor) Process(ctx context.Context, value any) (string, error) {
+	return fmt.Sprintf("processed_currency:$%.2f", value.(float64)), nil
+}
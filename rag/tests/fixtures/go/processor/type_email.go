@@ -0,0 +1,22 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+)
+
+// emailProcessor handles the "email" type.
+type emailProcessor struct{}
+
+func (emailProcessor) TypeName() string { return "email" }
+
+func (emailProcessor) Validate(value any) error {
+	if _, ok := value.(string); !ok {
+		return fmt.Errorf("expected email string, got %T", value)
+	}
+	return nil
+}
+
+func (emailProcessor) Process(ctx context.Context, value any) (string, error) {
+	return "processed_email:" + value.(string), nil
+}
@@ -0,0 +1,22 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+)
+
+// floatProcessor handles the "float" type.
+type floatProcessor struct{}
+
+func (floatProcessor) TypeName() string { return "float" }
+
+func (floatProcessor) Validate(value any) error {
+	if _, ok := value.(float64); !ok {
+		return fmt.Errorf("expected float64, got %T", value)
+	}
+	return nil
+}
+
+func (floatProcessor) Process(ctx context.Context, value any) (string, error) {
+	return fmt.Sprintf("processed_float:%.2f", value.(float64)*1.5), nil
+}
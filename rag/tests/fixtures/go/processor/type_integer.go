@@ -0,0 +1,22 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+)
+
+// integerProcessor handles the "integer" type.
+type integerProcessor struct{}
+
+func (integerProcessor) TypeName() string { return "integer" }
+
+func (integerProcessor) Validate(value any) error {
+	if _, ok := value.(int); !ok {
+		return fmt.Errorf("expected int, got %T", value)
+	}
+	return nil
+}
+
+func (integerProcessor) Process(ctx context.Context, value any) (string, error) {
+	return fmt.Sprintf("processed_int:%d", value.(int)*2), nil
+}
@@ -0,0 +1,22 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+)
+
+// jsonProcessor handles the "json" type.
+type jsonProcessor struct{}
+
+func (jsonProcessor) TypeName() string { return "json" }
+
+func (jsonProcessor) Validate(value any) error {
+	if _, ok := value.(string); !ok {
+		return fmt.Errorf("expected json string, got %T", value)
+	}
+	return nil
+}
+
+func (jsonProcessor) Process(ctx context.Context, value any) (string, error) {
+	return "processed_json:" + value.(string), nil
+}
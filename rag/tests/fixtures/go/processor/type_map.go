@@ -0,0 +1,22 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+)
+
+// mapProcessor handles the "map" type.
+type mapProcessor struct{}
+
+func (mapProcessor) TypeName() string { return "map" }
+
+func (mapProcessor) Validate(value any) error {
+	if _, ok := value.(map[string]any); !ok {
+		return fmt.Errorf("expected map, got %T", value)
+	}
+	return nil
+}
+
+func (mapProcessor) Process(ctx context.Context, value any) (string, error) {
+	return fmt.Sprintf("processed_map:keys=%d", len(value.(map[string]any))), nil
+}
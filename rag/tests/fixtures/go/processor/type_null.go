@@ -0,0 +1,22 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+)
+
+// nullProcessor handles the "null" type.
+type nullProcessor struct{}
+
+func (nullProcessor) TypeName() string { return "null" }
+
+func (nullProcessor) Validate(value any) error {
+	if value != nil {
+		return fmt.Errorf("expected nil, got %T", value)
+	}
+	return nil
+}
+
+func (nullProcessor) Process(ctx context.Context, value any) (string, error) {
+	return "processed_null:nil", nil
+}
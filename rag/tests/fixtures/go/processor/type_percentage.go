@@ -0,0 +1,22 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+)
+
+// percentageProcessor handles the "percentage" type.
+type percentageProcessor struct{}
+
+func (percentageProcessor) TypeName() string { return "percentage" }
+
+func (percentageProcessor) Validate(value any) error {
+	if _, ok := value.(float64); !ok {
+		return fmt.Errorf("expected percentage, got %T", value)
+	}
+	return nil
+}
+
+func (percentageProcessor) Process(ctx context.Context, value any) (string, error) {
+	return fmt.Sprintf("processed_percentage:%.1f%%", value.(float64)*100), nil
+}
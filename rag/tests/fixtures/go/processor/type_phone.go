@@ -0,0 +1,22 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+)
+
+// phoneProcessor handles the "phone" type.
+type phoneProcessor struct{}
+
+func (phoneProcessor) TypeName() string { return "phone" }
+
+func (phoneProcessor) Validate(value any) error {
+	if _, ok := value.(string); !ok {
+		return fmt.Errorf("expected phone string, got %T", value)
+	}
+	return nil
+}
+
+func (phoneProcessor) Process(ctx context.Context, value any) (string, error) {
+	return "processed_phone:" + value.(string), nil
+}
@@ -0,0 +1,22 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+)
+
+// statusProcessor handles the "status" type.
+type statusProcessor struct{}
+
+func (statusProcessor) TypeName() string { return "status" }
+
+func (statusProcessor) Validate(value any) error {
+	if _, ok := value.(string); !ok {
+		return fmt.Errorf("expected status string, got %T", value)
+	}
+	return nil
+}
+
+func (statusProcessor) Process(ctx context.Context, value any) (string, error) {
+	return "processed_status:" + value.(string), nil
+}
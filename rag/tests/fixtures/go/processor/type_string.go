@@ -0,0 +1,22 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+)
+
+// stringProcessor handles the "string" type.
+type stringProcessor struct{}
+
+func (stringProcessor) TypeName() string { return "string" }
+
+func (stringProcessor) Validate(value any) error {
+	if _, ok := value.(string); !ok {
+		return fmt.Errorf("expected string, got %T", value)
+	}
+	return nil
+}
+
+func (stringProcessor) Process(ctx context.Context, value any) (string, error) {
+	return "processed_string:" + value.(string), nil
+}
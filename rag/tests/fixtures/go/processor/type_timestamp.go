@@ -0,0 +1,22 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+)
+
+// timestampProcessor handles the "timestamp" type.
+type timestampProcessor struct{}
+
+func (timestampProcessor) TypeName() string { return "timestamp" }
+
+func (timestampProcessor) Validate(value any) error {
+	if _, ok := value.(string); !ok {
+		return fmt.Errorf("expected timestamp string, got %T", value)
+	}
+	return nil
+}
+
+func (timestampProcessor) Process(ctx context.Context, value any) (string, error) {
+	return "processed_timestamp:" + value.(string), nil
+}
@@ -0,0 +1,22 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+)
+
+// urlProcessor handles the "url" type.
+type urlProcessor struct{}
+
+func (urlProcessor) TypeName() string { return "url" }
+
+func (urlProcessor) Validate(value any) error {
+	if _, ok := value.(string); !ok {
+		return fmt.Errorf("expected url string, got %T", value)
+	}
+	return nil
+}
+
+func (urlProcessor) Process(ctx context.Context, value any) (string, error) {
+	return "processed_url:" + value.(string), nil
+}
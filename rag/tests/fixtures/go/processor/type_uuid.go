@@ -0,0 +1,22 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+)
+
+// uuidProcessor handles the "uuid" type.
+type uuidProcessor struct{}
+
+func (uuidProcessor) TypeName() string { return "uuid" }
+
+func (uuidProcessor) Validate(value any) error {
+	if _, ok := value.(string); !ok {
+		return fmt.Errorf("expected uuid string, got %T", value)
+	}
+	return nil
+}
+
+func (uuidProcessor) Process(ctx context.Context, value any) (string, error) {
+	return "processed_uuid:" + value.(string), nil
+}